@@ -0,0 +1,160 @@
+package generics
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// Number is a constraint made of a type set. The ~ also allows types whose
+// underlying type is one of these, like `type Celsius float64`.
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+func Map[T, U any](s []T, f func(T) U) []U {
+	out := make([]U, 0, len(s))
+	for _, v := range s {
+		out = append(out, f(v))
+	}
+	return out
+}
+
+func Filter[T any](s []T, keep func(T) bool) []T {
+	var out []T
+	for _, v := range s {
+		if keep(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func Reduce[T, A any](s []T, init A, f func(A, T) A) A {
+	acc := init
+	for _, v := range s {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+func Sum[T Number](s []T) T {
+	return Reduce(s, T(0), func(acc T, v T) T { return acc + v })
+}
+
+// Max works for any type that supports < and >. cmp.Ordered is the standard
+// library version of golang.org/x/exp/constraints.Ordered.
+func Max[T cmp.Ordered](s ...T) (T, bool) {
+	var m T
+	if len(s) == 0 {
+		return m, false
+	}
+	m = s[0]
+	for _, v := range s[1:] {
+		if v > m {
+			m = v
+		}
+	}
+	return m, true
+}
+
+// Stack is a generic LIFO. The zero value is ready to use.
+type Stack[T any] struct {
+	items []T
+}
+
+func (s *Stack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+	last := len(s.items) - 1
+	v := s.items[last]
+	s.items[last] = zero
+	s.items = s.items[:last]
+	return v, true
+}
+
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// Queue is a generic FIFO. The zero value is ready to use.
+type Queue[T any] struct {
+	items []T
+}
+
+func (q *Queue[T]) Enqueue(v T) {
+	q.items = append(q.items, v)
+}
+
+func (q *Queue[T]) Dequeue() (T, bool) {
+	var zero T
+	if len(q.items) == 0 {
+		return zero, false
+	}
+	v := q.items[0]
+	// Clear the slot so the backing array doesn't keep the value alive.
+	q.items[0] = zero
+	q.items = q.items[1:]
+	return v, true
+}
+
+func (q *Queue[T]) Len() int {
+	return len(q.items)
+}
+
+type Celsius float64
+
+func Run() {
+	fmt.Printf("=== Testing Generics ===\n")
+
+	nums := []int{1, 2, 3, 4, 5, 6}
+	squares := Map(nums, func(n int) int { return n * n })
+	fmt.Println("squares:", squares)
+
+	evens := Filter(nums, func(n int) bool { return n%2 == 0 })
+	fmt.Println("evens:", evens)
+
+	labels := Map(nums, func(n int) string { return fmt.Sprintf("#%d", n) })
+	fmt.Println("labels:", labels)
+
+	fmt.Println("sum:", Sum(nums))
+	fmt.Println("sum celsius:", Sum([]Celsius{20.5, 21, 19.5}))
+
+	longest := Reduce([]string{"go", "generics", "type"}, "", func(acc, s string) string {
+		if len(s) > len(acc) {
+			return s
+		}
+		return acc
+	})
+	fmt.Println("longest:", longest)
+
+	if m, ok := Max(3, 9, 4); ok {
+		fmt.Println("max int:", m)
+	}
+	if m, ok := Max("pear", "apple", "zucchini"); ok {
+		fmt.Println("max string:", m)
+	}
+
+	var s Stack[string]
+	s.Push("a")
+	s.Push("b")
+	s.Push("c")
+	for s.Len() > 0 {
+		v, _ := s.Pop()
+		fmt.Println("pop:", v)
+	}
+
+	var q Queue[int]
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	for q.Len() > 0 {
+		v, _ := q.Dequeue()
+		fmt.Println("dequeue:", v)
+	}
+}
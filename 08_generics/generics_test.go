@@ -0,0 +1,90 @@
+package generics
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMapFilterReduce(t *testing.T) {
+	nums := []int{1, 2, 3, 4}
+
+	if got := Map(nums, func(n int) int { return n * 10 }); !slices.Equal(got, []int{10, 20, 30, 40}) {
+		t.Errorf("Map = %v", got)
+	}
+	if got := Filter(nums, func(n int) bool { return n > 2 }); !slices.Equal(got, []int{3, 4}) {
+		t.Errorf("Filter = %v", got)
+	}
+	if got := Reduce(nums, "", func(acc string, n int) string { return acc + string(rune('0'+n)) }); got != "1234" {
+		t.Errorf("Reduce = %q", got)
+	}
+	if got := Sum([]Celsius{1.5, 2.5}); got != 4 {
+		t.Errorf("Sum = %v", got)
+	}
+}
+
+func TestMax(t *testing.T) {
+	if got, ok := Max(2, 7, 5); !ok || got != 7 {
+		t.Errorf("Max ints = %v, %v", got, ok)
+	}
+	if got, ok := Max("b", "c", "a"); !ok || got != "c" {
+		t.Errorf("Max strings = %q, %v", got, ok)
+	}
+	if _, ok := Max[float64](); ok {
+		t.Error("Max of nothing should report false")
+	}
+}
+
+func TestStack(t *testing.T) {
+	var s Stack[int]
+	s.Push(1)
+	s.Push(2)
+
+	if v, ok := s.Pop(); !ok || v != 2 {
+		t.Errorf("Pop = %v, %v, want 2, true", v, ok)
+	}
+	if v, ok := s.Pop(); !ok || v != 1 {
+		t.Errorf("Pop = %v, %v, want 1, true", v, ok)
+	}
+	if _, ok := s.Pop(); ok {
+		t.Error("Pop on empty stack should report false")
+	}
+}
+
+func TestQueue(t *testing.T) {
+	var q Queue[string]
+	q.Enqueue("a")
+	q.Enqueue("b")
+
+	if v, ok := q.Dequeue(); !ok || v != "a" {
+		t.Errorf("Dequeue = %q, %v, want a, true", v, ok)
+	}
+	if q.Len() != 1 {
+		t.Errorf("Len = %d, want 1", q.Len())
+	}
+	if v, ok := q.Dequeue(); !ok || v != "b" {
+		t.Errorf("Dequeue = %q, %v, want b, true", v, ok)
+	}
+	if _, ok := q.Dequeue(); ok {
+		t.Error("Dequeue on empty queue should report false")
+	}
+}
+
+func BenchmarkMap(b *testing.B) {
+	nums := make([]int, 1000)
+	for i := range nums {
+		nums[i] = i
+	}
+	for i := 0; i < b.N; i++ {
+		Map(nums, func(n int) int { return n * 2 })
+	}
+}
+
+func BenchmarkSum(b *testing.B) {
+	nums := make([]float64, 1000)
+	for i := range nums {
+		nums[i] = float64(i)
+	}
+	for i := 0; i < b.N; i++ {
+		Sum(nums)
+	}
+}
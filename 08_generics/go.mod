@@ -0,0 +1,3 @@
+module generics
+
+go 1.23.4
@@ -0,0 +1,103 @@
+package errorhandling
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors are plain values compared with errors.Is.
+var (
+	ErrUserNotFound    = errors.New("user not found")
+	ErrInvalidPassword = errors.New("invalid password")
+)
+
+// AuthError carries an HTTP status code next to the message, the same way
+// API code maps domain failures to responses. Err keeps the cause so that
+// errors.Is still sees the sentinel underneath.
+type AuthError struct {
+	Code    string
+	Message string
+	Status  int
+	Err     error
+}
+
+func (e *AuthError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Err)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+var users = map[string]string{
+	"raisul": "secret",
+}
+
+func findUser(name string) (string, error) {
+	password, ok := users[name]
+	if !ok {
+		return "", fmt.Errorf("find user %q: %w", name, ErrUserNotFound)
+	}
+	return password, nil
+}
+
+// Login wraps the lower level errors into an AuthError.
+func Login(name, password string) error {
+	stored, err := findUser(name)
+	if err != nil {
+		return &AuthError{Code: "LOGIN_FAILED", Message: "invalid credentials", Status: http.StatusUnauthorized, Err: err}
+	}
+	if stored != password {
+		return &AuthError{Code: "LOGIN_FAILED", Message: "invalid credentials", Status: http.StatusUnauthorized, Err: ErrInvalidPassword}
+	}
+	return nil
+}
+
+// StatusCode picks the HTTP status for any error returned by Login.
+func StatusCode(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	var authErr *AuthError
+	if errors.As(err, &authErr) {
+		return authErr.Status
+	}
+	return http.StatusInternalServerError
+}
+
+func Run() {
+	fmt.Printf("=== Testing Errors ===\n")
+
+	_, err := findUser("nobody")
+	fmt.Println("wrapped:", err)
+	fmt.Println("is ErrUserNotFound:", errors.Is(err, ErrUserNotFound))
+	fmt.Println("unwrapped:", errors.Unwrap(err))
+
+	attempts := []struct{ name, password string }{
+		{"raisul", "secret"},
+		{"raisul", "wrong"},
+		{"nobody", "secret"},
+	}
+	for _, a := range attempts {
+		err := Login(a.name, a.password)
+		fmt.Printf("login %s/%s -> status %d, err: %v\n", a.name, a.password, StatusCode(err), err)
+
+		var authErr *AuthError
+		if errors.As(err, &authErr) {
+			fmt.Println("  code:", authErr.Code)
+		}
+		switch {
+		case errors.Is(err, ErrUserNotFound):
+			fmt.Println("  cause: unknown user")
+		case errors.Is(err, ErrInvalidPassword):
+			fmt.Println("  cause: bad password")
+		}
+	}
+
+	joined := errors.Join(ErrUserNotFound, ErrInvalidPassword)
+	fmt.Println("joined is ErrInvalidPassword:", errors.Is(joined, ErrInvalidPassword))
+}
@@ -0,0 +1,59 @@
+package errorhandling
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestLogin(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     string
+		password string
+		wantErr  error
+		status   int
+	}{
+		{name: "valid credentials", user: "raisul", password: "secret", wantErr: nil, status: http.StatusOK},
+		{name: "wrong password", user: "raisul", password: "nope", wantErr: ErrInvalidPassword, status: http.StatusUnauthorized},
+		{name: "unknown user", user: "nobody", password: "secret", wantErr: ErrUserNotFound, status: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Login(tt.user, tt.password)
+
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("Login() error = %v, want nil", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Login() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := StatusCode(err); got != tt.status {
+				t.Errorf("StatusCode() = %d, want %d", got, tt.status)
+			}
+		})
+	}
+}
+
+func TestStatusCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "nil", err: nil, want: http.StatusOK},
+		{name: "plain error", err: errors.New("boom"), want: http.StatusInternalServerError},
+		{name: "auth error", err: &AuthError{Status: http.StatusForbidden}, want: http.StatusForbidden},
+		{name: "wrapped auth error", err: fmt.Errorf("handler: %w", &AuthError{Status: http.StatusConflict}), want: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StatusCode(tt.err); got != tt.want {
+				t.Errorf("StatusCode() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
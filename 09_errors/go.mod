@@ -0,0 +1,3 @@
+module errorhandling
+
+go 1.23.4
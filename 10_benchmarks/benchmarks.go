@@ -0,0 +1,101 @@
+package benchmarking
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"strings"
+	"testing"
+)
+
+// JoinPlus is the "before" version: every += copies the whole string built
+// so far, so it allocates on each iteration.
+func JoinPlus(parts []string, sep string) string {
+	s := ""
+	for i, p := range parts {
+		if i > 0 {
+			s += sep
+		}
+		s += p
+	}
+	return s
+}
+
+// JoinBuilder is the "after" version: the final size is known up front, so
+// strings.Builder allocates once.
+func JoinBuilder(parts []string, sep string) string {
+	if len(parts) == 0 {
+		return ""
+	}
+
+	n := len(sep) * (len(parts) - 1)
+	for _, p := range parts {
+		n += len(p)
+	}
+
+	var b strings.Builder
+	b.Grow(n)
+	for i, p := range parts {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+func words(n int) []string {
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = fmt.Sprintf("word%d", i)
+	}
+	return parts
+}
+
+func Run() {
+	fmt.Printf("=== Testing Benchmarks ===\n")
+
+	parts := words(1000)
+
+	// testing.Benchmark runs a benchmark function outside of `go test`, the
+	// same loop `go test -bench . -benchmem` runs for BenchmarkJoin*.
+	for _, bench := range []struct {
+		name string
+		join func([]string, string) string
+	}{
+		{"JoinPlus", JoinPlus},
+		{"JoinBuilder", JoinBuilder},
+	} {
+		r := testing.Benchmark(func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bench.join(parts, ",")
+			}
+		})
+		fmt.Printf("%-12s %s %s\n", bench.name, r.String(), r.MemString())
+	}
+
+	// Capture a CPU profile of the slow version. Inspect it with
+	// `go tool pprof -top <file>`, or run
+	// `go test -bench . -cpuprofile cpu.out` in 10_benchmarks.
+	path := filepath.Join(os.TempDir(), "join_cpu.out")
+	f, err := os.Create(path)
+	if err != nil {
+		fmt.Println("create profile:", err)
+		return
+	}
+	defer f.Close()
+
+	if err := pprof.StartCPUProfile(f); err != nil {
+		fmt.Println("start profile:", err)
+		return
+	}
+	for i := 0; i < 200; i++ {
+		JoinPlus(parts, ",")
+	}
+	pprof.StopCPUProfile()
+
+	fmt.Println("cpu profile written to", path)
+	fmt.Println("view it with: go tool pprof -top", path)
+}
@@ -0,0 +1,48 @@
+package benchmarking
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts []string
+	}{
+		{name: "empty", parts: nil},
+		{name: "single", parts: []string{"go"}},
+		{name: "many", parts: []string{"a", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := strings.Join(tt.parts, ",")
+			if got := JoinPlus(tt.parts, ","); got != want {
+				t.Errorf("JoinPlus() = %q, want %q", got, want)
+			}
+			if got := JoinBuilder(tt.parts, ","); got != want {
+				t.Errorf("JoinBuilder() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// Run with: go test -bench . -benchmem
+func BenchmarkJoinPlus(b *testing.B) {
+	parts := words(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		JoinPlus(parts, ",")
+	}
+}
+
+func BenchmarkJoinBuilder(b *testing.B) {
+	parts := words(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		JoinBuilder(parts, ",")
+	}
+}
@@ -0,0 +1,3 @@
+module benchmarking
+
+go 1.23.4
@@ -0,0 +1,3 @@
+module dependencyinjection
+
+go 1.23.4
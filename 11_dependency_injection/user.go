@@ -0,0 +1,158 @@
+package dependencyinjection
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrUserExists   = errors.New("user already exists")
+	ErrInvalidEmail = errors.New("invalid email")
+)
+
+type User struct {
+	ID    int
+	Name  string
+	Email string
+}
+
+// UserRepository is what the service depends on. The service never knows
+// whether users live in Postgres or in a map.
+type UserRepository interface {
+	Create(u User) (User, error)
+	FindByID(id int) (User, error)
+	FindByEmail(email string) (User, error)
+	List() ([]User, error)
+}
+
+// InMemoryUserRepository is a fake backed by a map. IDs are handed out in
+// order so results are deterministic.
+type InMemoryUserRepository struct {
+	mu     sync.Mutex
+	users  map[int]User
+	nextID int
+}
+
+// Compile-time check that the fake satisfies the interface.
+var _ UserRepository = (*InMemoryUserRepository)(nil)
+
+func NewInMemoryUserRepository() *InMemoryUserRepository {
+	return &InMemoryUserRepository{users: make(map[int]User), nextID: 1}
+}
+
+func (r *InMemoryUserRepository) Create(u User) (User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.users {
+		if existing.Email == u.Email {
+			return User{}, ErrUserExists
+		}
+	}
+	u.ID = r.nextID
+	r.nextID++
+	r.users[u.ID] = u
+	return u, nil
+}
+
+func (r *InMemoryUserRepository) FindByID(id int) (User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, ok := r.users[id]
+	if !ok {
+		return User{}, ErrUserNotFound
+	}
+	return u, nil
+}
+
+func (r *InMemoryUserRepository) FindByEmail(email string) (User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, u := range r.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return User{}, ErrUserNotFound
+}
+
+func (r *InMemoryUserRepository) List() ([]User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	users := make([]User, 0, len(r.users))
+	for _, u := range r.users {
+		users = append(users, u)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users, nil
+}
+
+// UserService holds the business rules. The repository is passed in through
+// the constructor instead of being created inside the service.
+type UserService struct {
+	repo UserRepository
+}
+
+func NewUserService(repo UserRepository) *UserService {
+	return &UserService{repo: repo}
+}
+
+func (s *UserService) Register(name, email string) (User, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if !strings.Contains(email, "@") {
+		return User{}, ErrInvalidEmail
+	}
+	u, err := s.repo.Create(User{Name: name, Email: email})
+	if err != nil {
+		return User{}, fmt.Errorf("register %s: %w", email, err)
+	}
+	return u, nil
+}
+
+func (s *UserService) Get(id int) (User, error) {
+	u, err := s.repo.FindByID(id)
+	if err != nil {
+		return User{}, fmt.Errorf("get user %d: %w", id, err)
+	}
+	return u, nil
+}
+
+func (s *UserService) List() ([]User, error) {
+	return s.repo.List()
+}
+
+func Run() {
+	fmt.Printf("=== Testing Dependency Injection ===\n")
+
+	// Wiring happens once, at the edge of the program.
+	repo := NewInMemoryUserRepository()
+	service := NewUserService(repo)
+
+	for _, in := range []struct{ name, email string }{
+		{"Raisul", "raisul@example.com"},
+		{"Rochi", "ROCHI@example.com "},
+		{"Again", "raisul@example.com"},
+		{"Broken", "not-an-email"},
+	} {
+		u, err := service.Register(in.name, in.email)
+		if err != nil {
+			fmt.Println("register failed:", err)
+			continue
+		}
+		fmt.Printf("registered: %+v\n", u)
+	}
+
+	if _, err := service.Get(42); errors.Is(err, ErrUserNotFound) {
+		fmt.Println("lookup failed:", err)
+	}
+
+	users, _ := service.List()
+	fmt.Println("users:", users)
+}
@@ -0,0 +1,40 @@
+package dependencyinjection
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUserServiceRegister(t *testing.T) {
+	service := NewUserService(NewInMemoryUserRepository())
+
+	u, err := service.Register("Raisul", " Raisul@Example.com")
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if u.ID != 1 || u.Email != "raisul@example.com" {
+		t.Errorf("Register() = %+v", u)
+	}
+
+	if _, err := service.Register("Dup", "raisul@example.com"); !errors.Is(err, ErrUserExists) {
+		t.Errorf("Register() duplicate error = %v, want %v", err, ErrUserExists)
+	}
+	if _, err := service.Register("Bad", "nope"); !errors.Is(err, ErrInvalidEmail) {
+		t.Errorf("Register() invalid error = %v, want %v", err, ErrInvalidEmail)
+	}
+}
+
+func TestUserServiceGet(t *testing.T) {
+	repo := NewInMemoryUserRepository()
+	service := NewUserService(repo)
+
+	created, _ := repo.Create(User{Name: "Rochi", Email: "rochi@example.com"})
+
+	got, err := service.Get(created.ID)
+	if err != nil || got != created {
+		t.Errorf("Get() = %+v, %v, want %+v", got, err, created)
+	}
+	if _, err := service.Get(99); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("Get() missing error = %v, want %v", err, ErrUserNotFound)
+	}
+}
@@ -0,0 +1,3 @@
+module jsonexercise
+
+go 1.23.4
@@ -0,0 +1,167 @@
+package jsonexercise
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Status is stored as an int but travels as a string.
+type Status int
+
+const (
+	StatusPending Status = iota
+	StatusActive
+	StatusBlocked
+)
+
+var statusNames = map[Status]string{
+	StatusPending: "pending",
+	StatusActive:  "active",
+	StatusBlocked: "blocked",
+}
+
+func (s Status) MarshalJSON() ([]byte, error) {
+	name, ok := statusNames[s]
+	if !ok {
+		return nil, fmt.Errorf("unknown status %d", int(s))
+	}
+	return json.Marshal(name)
+}
+
+func (s *Status) UnmarshalJSON(data []byte) error {
+	// By convention null is a no-op and leaves the value as it was.
+	if string(data) == "null" {
+		return nil
+	}
+
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	for status, n := range statusNames {
+		if n == name {
+			*s = status
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown status %q", name)
+}
+
+type Account struct {
+	ID     int    `json:"id"`
+	Email  string `json:"email"`
+	Status Status `json:"status"`
+	// omitempty drops zero values, so a real balance of 0 disappears too.
+	Balance int `json:"balance,omitempty"`
+	// A pointer tells "not set" (nil) apart from "set to 0".
+	Credits  *int   `json:"credits,omitempty"`
+	Password string `json:"-"`
+}
+
+// Event keeps Payload undecoded until Type says what it contains.
+type Event struct {
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+type LoginPayload struct {
+	Email string `json:"email"`
+}
+
+type LogoutPayload struct {
+	SessionID string `json:"session_id"`
+}
+
+func DecodeEvent(data []byte) (any, error) {
+	var e Event
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, err
+	}
+	switch e.Type {
+	case "login":
+		var p LoginPayload
+		err := json.Unmarshal(e.Payload, &p)
+		return p, err
+	case "logout":
+		var p LogoutPayload
+		err := json.Unmarshal(e.Payload, &p)
+		return p, err
+	default:
+		return nil, fmt.Errorf("unknown event type %q", e.Type)
+	}
+}
+
+// DecodeStream reads one JSON value after another (JSON lines) without
+// loading the whole input in memory.
+func DecodeStream(r io.Reader) ([]Account, error) {
+	dec := json.NewDecoder(r)
+	var accounts []Account
+	for {
+		var a Account
+		err := dec.Decode(&a)
+		if errors.Is(err, io.EOF) {
+			return accounts, nil
+		}
+		if err != nil {
+			return accounts, err
+		}
+		accounts = append(accounts, a)
+	}
+}
+
+// DecodeStrict rejects unknown fields and trailing data, which catches typos
+// in request bodies like "emial".
+func DecodeStrict(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	// More() would miss a stray } or ], so try to read one more value and
+	// require the input to end instead.
+	if err := dec.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		return errors.New("unexpected data after JSON value")
+	}
+	return nil
+}
+
+func Run() {
+	fmt.Printf("=== Testing JSON ===\n")
+
+	zero := 0
+	accounts := []Account{
+		{ID: 1, Email: "raisul@example.com", Status: StatusActive, Balance: 0, Password: "secret"},
+		{ID: 2, Email: "rochi@example.com", Status: StatusPending, Balance: 50, Credits: &zero},
+	}
+	for _, a := range accounts {
+		out, _ := json.Marshal(a)
+		fmt.Println("marshal:", string(out))
+	}
+
+	var a Account
+	_ = json.Unmarshal([]byte(`{"id":3,"email":"x@example.com","status":"blocked"}`), &a)
+	fmt.Printf("unmarshal: %+v (credits set: %v)\n", a, a.Credits != nil)
+
+	for _, raw := range []string{
+		`{"type":"login","payload":{"email":"raisul@example.com"}}`,
+		`{"type":"logout","payload":{"session_id":"abc"}}`,
+		`{"type":"unknown","payload":{}}`,
+	} {
+		e, err := DecodeEvent([]byte(raw))
+		fmt.Printf("event: %#v err: %v\n", e, err)
+	}
+
+	stream := strings.NewReader(`{"id":1,"email":"a@example.com","status":"active"}
+{"id":2,"email":"b@example.com","status":"pending"}
+`)
+	streamed, err := DecodeStream(stream)
+	fmt.Println("stream:", len(streamed), "accounts, err:", err)
+
+	var strict Account
+	err = DecodeStrict([]byte(`{"id":1,"emial":"typo@example.com"}`), &strict)
+	fmt.Println("strict:", err)
+}
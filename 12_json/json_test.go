@@ -0,0 +1,107 @@
+package jsonexercise
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestAccountMarshal(t *testing.T) {
+	zero := 0
+	tests := []struct {
+		name    string
+		account Account
+		want    string
+	}{
+		{
+			name:    "omitempty drops zero balance and nil credits",
+			account: Account{ID: 1, Email: "a@example.com", Status: StatusActive, Password: "secret"},
+			want:    `{"id":1,"email":"a@example.com","status":"active"}`,
+		},
+		{
+			name:    "pointer keeps explicit zero",
+			account: Account{ID: 2, Email: "b@example.com", Status: StatusBlocked, Balance: 5, Credits: &zero},
+			want:    `{"id":2,"email":"b@example.com","status":"blocked","balance":5,"credits":0}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.account)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusUnmarshal(t *testing.T) {
+	var s Status
+	if err := json.Unmarshal([]byte(`"blocked"`), &s); err != nil || s != StatusBlocked {
+		t.Errorf("Unmarshal() = %v, %v, want %v", s, err, StatusBlocked)
+	}
+	if err := json.Unmarshal([]byte(`null`), &s); err != nil || s != StatusBlocked {
+		t.Errorf("Unmarshal() null = %v, %v, want %v unchanged", s, err, StatusBlocked)
+	}
+	if err := json.Unmarshal([]byte(`"deleted"`), &s); err == nil {
+		t.Error("Unmarshal() unknown status should fail")
+	}
+
+	a := Account{Status: StatusActive}
+	if err := DecodeStrict([]byte(`{"id":1,"status":null}`), &a); err != nil || a.Status != StatusActive {
+		t.Errorf("DecodeStrict() null status = %v, %v, want %v unchanged", a.Status, err, StatusActive)
+	}
+}
+
+func TestDecodeEvent(t *testing.T) {
+	got, err := DecodeEvent([]byte(`{"type":"logout","payload":{"session_id":"abc"}}`))
+	if err != nil {
+		t.Fatalf("DecodeEvent() error = %v", err)
+	}
+	if p, ok := got.(LogoutPayload); !ok || p.SessionID != "abc" {
+		t.Errorf("DecodeEvent() = %#v", got)
+	}
+	if _, err := DecodeEvent([]byte(`{"type":"other","payload":{}}`)); err == nil {
+		t.Error("DecodeEvent() unknown type should fail")
+	}
+}
+
+func TestDecodeStream(t *testing.T) {
+	in := strings.NewReader(`{"id":1,"status":"active"} {"id":2,"status":"pending"}`)
+	got, err := DecodeStream(in)
+	if err != nil {
+		t.Fatalf("DecodeStream() error = %v", err)
+	}
+	if len(got) != 2 || got[1].ID != 2 {
+		t.Errorf("DecodeStream() = %+v", got)
+	}
+}
+
+func TestDecodeStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "known fields", input: `{"id":1,"email":"a@example.com"}`},
+		{name: "unknown field", input: `{"id":1,"emial":"a@example.com"}`, wantErr: true},
+		{name: "trailing value", input: `{"id":1} {"id":2}`, wantErr: true},
+		{name: "trailing brace", input: `{"id":1}}`, wantErr: true},
+		{name: "trailing bracket", input: `{"id":1}]`, wantErr: true},
+		{name: "trailing garbage", input: `{"id":1} garbage`, wantErr: true},
+		{name: "trailing whitespace", input: "{\"id\":1}\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Account
+			err := DecodeStrict([]byte(tt.input), &a)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DecodeStrict() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package httpexercise
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Client is a small API client. The http.Client timeout bounds a single
+// attempt, the context bounds the whole call including retries.
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+	Retries    int
+	Backoff    time.Duration
+}
+
+func NewClient(baseURL string) *Client {
+	return &Client{
+		BaseURL:    baseURL,
+		HTTPClient: &http.Client{Timeout: 2 * time.Second},
+		Retries:    3,
+		Backoff:    100 * time.Millisecond,
+	}
+}
+
+// Get retries network errors and 5xx responses, doubling the wait each time.
+// 4xx responses are returned straight away since retrying won't fix them.
+func (c *Client) Get(ctx context.Context, path string) (int, []byte, error) {
+	var lastErr error
+	wait := c.Backoff
+
+	for attempt := 0; attempt <= c.Retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(wait):
+				wait *= 2
+			case <-ctx.Done():
+				return 0, nil, ctx.Err()
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+path, nil)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if resp.StatusCode >= http.StatusInternalServerError {
+			lastErr = fmt.Errorf("server error: %s", resp.Status)
+			continue
+		}
+		return resp.StatusCode, body, nil
+	}
+
+	return 0, nil, fmt.Errorf("giving up after %d attempts: %w", c.Retries+1, lastErr)
+}
@@ -0,0 +1,3 @@
+module httpexercise
+
+go 1.23.4
@@ -0,0 +1,140 @@
+package httpexercise
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestServer(t *testing.T) {
+	handler := NewServer(log.New(io.Discard, "", 0))
+
+	tests := []struct {
+		name   string
+		target string
+		status int
+		body   string
+	}{
+		{name: "health", target: "/health", status: http.StatusOK, body: `"status":"ok"`},
+		{name: "greet", target: "/greet?name=Go", status: http.StatusOK, body: `"message":"Hello Go"`},
+		{name: "greet without name", target: "/greet", status: http.StatusBadRequest, body: `"error"`},
+		{name: "unknown route", target: "/nope", status: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if rec.Code != tt.status {
+				t.Errorf("status = %d, want %d", rec.Code, tt.status)
+			}
+			if !strings.Contains(rec.Body.String(), tt.body) {
+				t.Errorf("body = %q, want it to contain %q", rec.Body.String(), tt.body)
+			}
+			if rec.Header().Get("X-Request-ID") == "" {
+				t.Error("missing X-Request-ID header")
+			}
+		})
+	}
+}
+
+func TestRequestIDIsKept(t *testing.T) {
+	t.Run("provided", func(t *testing.T) {
+		var logs bytes.Buffer
+		handler := NewServer(log.New(&logs, "", 0))
+
+		req := httptest.NewRequest(http.MethodGet, "/health", nil)
+		req.Header.Set("X-Request-ID", "abc123")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if got := rec.Header().Get("X-Request-ID"); got != "abc123" {
+			t.Errorf("X-Request-ID = %q, want abc123", got)
+		}
+		if !strings.Contains(logs.String(), "id=abc123") {
+			t.Errorf("log = %q, want it to contain id=abc123", logs.String())
+		}
+	})
+
+	t.Run("generated", func(t *testing.T) {
+		var got string
+		handler := requestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = RequestIDFromContext(r.Context())
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if got == "" || got != rec.Header().Get("X-Request-ID") {
+			t.Errorf("RequestIDFromContext() = %q, response header = %q", got, rec.Header().Get("X-Request-ID"))
+		}
+		if h := req.Header.Get("X-Request-ID"); h != "" {
+			t.Errorf("incoming request header modified to %q", h)
+		}
+	})
+}
+
+func TestClientRetries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, "ok")
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL)
+	client.Backoff = time.Millisecond
+
+	status, body, err := client.Get(context.Background(), "/")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if status != http.StatusOK || string(body) != "ok" {
+		t.Errorf("Get() = %d %q", status, body)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("server called %d times, want 3", calls.Load())
+	}
+}
+
+func TestClientGivesUp(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL)
+	client.Retries = 2
+	client.Backoff = time.Millisecond
+
+	if _, _, err := client.Get(context.Background(), "/"); err == nil {
+		t.Error("Get() should fail after exhausting retries")
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL)
+	client.HTTPClient.Timeout = 20 * time.Millisecond
+	client.Retries = 0
+
+	if _, _, err := client.Get(context.Background(), "/"); err == nil {
+		t.Error("Get() should time out")
+	}
+}
@@ -0,0 +1,46 @@
+package httpexercise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"time"
+)
+
+func Run() {
+	fmt.Printf("=== Testing HTTP ===\n")
+
+	// Port 0 lets the OS pick a free port.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		fmt.Println("listen:", err)
+		return
+	}
+
+	logger := log.New(os.Stdout, "server: ", 0)
+	srv := &http.Server{Handler: NewServer(logger), ReadHeaderTimeout: 5 * time.Second}
+	go srv.Serve(ln)
+
+	client := NewClient("http://" + ln.Addr().String())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, path := range []string{"/health", "/greet?name=Raisul", "/greet", "/missing"} {
+		status, body, err := client.Get(ctx, path)
+		if err != nil {
+			fmt.Println("client:", err)
+			continue
+		}
+		// Both the JSON encoder and the 404 page end the body with a newline.
+		fmt.Printf("client: GET %s -> %d %s", path, status, body)
+	}
+
+	shutdownCtx, stop := context.WithTimeout(context.Background(), time.Second)
+	defer stop()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		fmt.Println("shutdown:", err)
+	}
+}
@@ -0,0 +1,3 @@
+module mapsexercise
+
+go 1.23.4
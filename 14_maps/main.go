@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+func main() {
+	fmt.Printf("=== Testing Maps ===\n")
+
+	m := make(map[string]int)
+	m["k1"] = 7
+	m["k2"] = 13
+	fmt.Println("map:", m)
+	fmt.Println("len:", len(m))
+
+	// A missing key returns the zero value, use the second result to tell
+	// "missing" apart from "stored 0".
+	v, ok := m["k3"]
+	fmt.Println("k3:", v, "present:", ok)
+
+	delete(m, "k2")
+	fmt.Println("after delete:", m)
+
+	ages := map[string]int{"raisul": 30, "rochi": 25, "go": 15}
+
+	// Iteration order is random on purpose, it can change between runs.
+	for name, age := range ages {
+		fmt.Println("unordered:", name, age)
+	}
+
+	// Sort the keys for a stable order.
+	for _, name := range slices.Sorted(maps.Keys(ages)) {
+		fmt.Println("sorted:", name, ages[name])
+	}
+
+	// Deleting while ranging is allowed.
+	for name, age := range ages {
+		if age < 20 {
+			delete(ages, name)
+		}
+	}
+	fmt.Println("adults:", ages)
+
+	// Nested maps: the inner map must be created before writing into it.
+	seats := map[string]map[string]int{}
+	book := func(event, name string, n int) {
+		if seats[event] == nil {
+			seats[event] = map[string]int{}
+		}
+		seats[event][name] += n
+	}
+	book("go-conf", "raisul", 2)
+	book("go-conf", "rochi", 1)
+	book("meetup", "raisul", 1)
+	fmt.Println("nested:", seats)
+	fmt.Println("go-conf raisul:", seats["go-conf"]["raisul"])
+
+	// Reading from a nil map is fine, writing to it panics.
+	var empty map[string]int
+	fmt.Println("nil map read:", empty["x"], len(empty))
+
+	clear(m)
+	fmt.Println("after clear:", m)
+}
@@ -0,0 +1,3 @@
+module structsexercise
+
+go 1.23.4
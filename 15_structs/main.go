@@ -0,0 +1,72 @@
+package main
+
+import "fmt"
+
+type Address struct {
+	City    string
+	Country string
+}
+
+type Person struct {
+	Name string
+	Age  int
+	Address
+}
+
+type Timestamps struct {
+	CreatedAt string
+	UpdatedAt string
+}
+
+func (t Timestamps) Describe() string {
+	return "created " + t.CreatedAt
+}
+
+// User embeds Timestamps, so its fields and methods are promoted.
+type User struct {
+	Person
+	Timestamps
+	Email string
+}
+
+func newPerson(name string, age int) *Person {
+	// Returning a pointer to a local is safe, it escapes to the heap.
+	return &Person{Name: name, Age: age}
+}
+
+func main() {
+	fmt.Printf("=== Testing Structs ===\n")
+
+	p := Person{Name: "Raisul", Age: 30}
+	fmt.Println("struct:", p)
+	fmt.Printf("fields: %+v\n", p)
+
+	p.City = "Dhaka" // promoted from Address
+	fmt.Println("city:", p.City, p.Address.City)
+
+	ptr := newPerson("Rochi", 25)
+	ptr.Age++ // pointers are dereferenced automatically
+	fmt.Println("pointer:", *ptr)
+
+	// Structs are values, assigning copies them.
+	copyOf := p
+	copyOf.Name = "Copy"
+	fmt.Println("original:", p.Name, "copy:", copyOf.Name)
+
+	u := User{
+		Person:     Person{Name: "Go", Age: 15, Address: Address{City: "Mountain View", Country: "US"}},
+		Timestamps: Timestamps{CreatedAt: "2009-11-10"},
+		Email:      "go@example.com",
+	}
+	fmt.Println("embedded:", u.Name, u.Country, u.Email)
+	fmt.Println("promoted method:", u.Describe())
+
+	// Anonymous structs are handy for one-off data.
+	point := struct {
+		X, Y int
+	}{1, 2}
+	fmt.Println("anonymous:", point)
+
+	// Structs with comparable fields can be compared with ==.
+	fmt.Println("equal:", Address{"Dhaka", "BD"} == Address{City: "Dhaka", Country: "BD"})
+}
@@ -0,0 +1,68 @@
+package main
+
+import "fmt"
+
+type Counter struct {
+	name  string
+	count int
+}
+
+// Value receiver: works on a copy, good for reading.
+func (c Counter) String() string {
+	return fmt.Sprintf("%s=%d", c.name, c.count)
+}
+
+// Value receiver trying to modify: only the copy changes.
+func (c Counter) IncrementCopy() {
+	c.count++
+}
+
+// Pointer receiver: modifies the caller's value.
+func (c *Counter) Increment() {
+	c.count++
+}
+
+type Rect struct {
+	Width, Height float64
+}
+
+func (r Rect) Area() float64 {
+	return r.Width * r.Height
+}
+
+func (r *Rect) Scale(f float64) {
+	r.Width *= f
+	r.Height *= f
+}
+
+func main() {
+	fmt.Printf("=== Testing Methods ===\n")
+
+	c := Counter{name: "clicks"}
+	c.IncrementCopy()
+	fmt.Println("after value receiver:", c)
+
+	c.Increment() // Go takes &c for us
+	fmt.Println("after pointer receiver:", c)
+
+	p := &c
+	p.Increment()
+	fmt.Println("via pointer:", p.String()) // and dereferences for value methods
+
+	r := Rect{Width: 2, Height: 3}
+	fmt.Println("area:", r.Area())
+	r.Scale(2)
+	fmt.Println("scaled area:", r.Area())
+
+	// Method values bind the receiver, method expressions take it as the
+	// first argument.
+	area := r.Area
+	fmt.Println("method value:", area())
+	fmt.Println("method expression:", Rect.Area(Rect{Width: 1, Height: 1}))
+
+	// Values stored in a map are not addressable, so pointer methods can't be
+	// called on them directly. Store pointers instead.
+	counters := map[string]*Counter{"a": {name: "a"}}
+	counters["a"].Increment()
+	fmt.Println("map of pointers:", counters["a"])
+}
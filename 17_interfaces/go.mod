@@ -0,0 +1,3 @@
+module interfaces
+
+go 1.23.4
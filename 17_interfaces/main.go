@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+type Shape interface {
+	Area() float64
+	Perimeter() float64
+}
+
+type Rect struct {
+	Width, Height float64
+}
+
+func (r Rect) Area() float64      { return r.Width * r.Height }
+func (r Rect) Perimeter() float64 { return 2 * (r.Width + r.Height) }
+
+type Circle struct {
+	Radius float64
+}
+
+func (c *Circle) Area() float64      { return math.Pi * c.Radius * c.Radius }
+func (c *Circle) Perimeter() float64 { return 2 * math.Pi * c.Radius }
+
+// Compile-time checks: the build fails if a type stops satisfying Shape.
+// Rect has value receivers, so both Rect and *Rect satisfy it. Circle has
+// pointer receivers, so only *Circle does.
+var (
+	_ Shape = Rect{}
+	_ Shape = (*Rect)(nil)
+	_ Shape = (*Circle)(nil)
+)
+
+func describe(s Shape) {
+	fmt.Printf("%T area=%.2f perimeter=%.2f\n", s, s.Area(), s.Perimeter())
+}
+
+func main() {
+	fmt.Printf("=== Testing Interfaces ===\n")
+
+	shapes := []Shape{Rect{Width: 3, Height: 4}, &Circle{Radius: 1}}
+	for _, s := range shapes {
+		describe(s)
+	}
+
+	// Type assertion with the ok form never panics.
+	for _, s := range shapes {
+		if c, ok := s.(*Circle); ok {
+			fmt.Println("circle radius:", c.Radius)
+		}
+	}
+
+	// Checking for an optional interface at runtime.
+	var s any = Rect{Width: 1, Height: 1}
+	if str, ok := s.(fmt.Stringer); ok {
+		fmt.Println("stringer:", str.String())
+	} else {
+		fmt.Println("Rect is not a fmt.Stringer")
+	}
+
+	// An interface holding a nil pointer is not itself nil.
+	var c *Circle
+	var shape Shape = c
+	fmt.Println("nil pointer:", c == nil, "interface nil:", shape == nil)
+}
@@ -0,0 +1,3 @@
+module timeexercise
+
+go 1.23.4
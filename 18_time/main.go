@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// query stands in for a database call: it either finishes after d or gives
+// up when the context is done, whichever comes first.
+func query(ctx context.Context, d time.Duration) (string, error) {
+	select {
+	case <-time.After(d):
+		return "rows", nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func main() {
+	fmt.Printf("=== Testing Time ===\n")
+
+	// Timer fires once. Stop reports whether it stopped it before firing.
+	timer := time.NewTimer(20 * time.Millisecond)
+	<-timer.C
+	fmt.Println("timer fired")
+
+	timer = time.NewTimer(time.Second)
+	fmt.Println("timer stopped before firing:", timer.Stop())
+
+	timer.Reset(10 * time.Millisecond)
+	<-timer.C
+	fmt.Println("timer fired after reset")
+
+	// Ticker fires repeatedly until stopped.
+	ticker := time.NewTicker(10 * time.Millisecond)
+	for i := 1; i <= 3; i++ {
+		t := <-ticker.C
+		fmt.Println("tick", i, t.Format("15:04:05.000"))
+	}
+	ticker.Stop()
+
+	// A producer sending a message every 20ms until done is closed.
+	messages := make(chan int)
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(20 * time.Millisecond)
+		defer ticker.Stop()
+		for i := 0; ; i++ {
+			<-ticker.C
+			select {
+			case messages <- i:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	// Pitfall: time.After inside the loop creates a new timer on every
+	// iteration, so the 50ms timeout restarts each time a message arrives
+	// and never fires while messages keep coming.
+	begin := time.Now()
+	received, timedOut := 0, false
+	for received < 10 && !timedOut {
+		select {
+		case <-messages:
+			received++
+		case <-time.After(50 * time.Millisecond):
+			timedOut = true
+		}
+	}
+	fmt.Printf("time.After in loop: %d messages in %v, timed out: %v\n",
+		received, time.Since(begin).Round(10*time.Millisecond), timedOut)
+
+	// Fix: create the timer once, before the loop, for an overall deadline.
+	begin = time.Now()
+	received = 0
+	deadline := time.NewTimer(50 * time.Millisecond)
+	defer deadline.Stop()
+loop:
+	for {
+		select {
+		case <-messages:
+			received++
+		case <-deadline.C:
+			break loop
+		}
+	}
+	close(done)
+	fmt.Printf("single timer: %d messages in %v, timed out: true\n",
+		received, time.Since(begin).Round(10*time.Millisecond))
+
+	// time.Now carries a monotonic clock reading that Sub and Since use, so
+	// elapsed time is right even if the wall clock is changed meanwhile. It
+	// shows up as the m=+... suffix, and Round(0) strips it.
+	start := time.Now()
+	time.Sleep(5 * time.Millisecond)
+	fmt.Println("elapsed >= 5ms:", time.Since(start) >= 5*time.Millisecond)
+	fmt.Println("with monotonic:", start)
+	fmt.Println("round(0):      ", start.Round(0))
+
+	// Context deadlines bound a call the same way a query timeout does.
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	if res, err := query(ctx, 10*time.Millisecond); err == nil {
+		fmt.Println("fast query:", res)
+	}
+	if _, err := query(ctx, time.Second); errors.Is(err, context.DeadlineExceeded) {
+		fmt.Println("slow query:", err)
+	}
+
+	if d, ok := ctx.Deadline(); ok {
+		fmt.Println("deadline passed:", time.Now().After(d))
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+
+	"benchmarking"
+	"dependencyinjection"
+	"errorhandling"
+	"generics"
+	"httpexercise"
+	"jsonexercise"
+)
+
+// exercise is a runnable exercise. Exercises written as package main are
+// started from their folder with `go run`, exercises written as a regular
+// package expose a Run function that is called directly.
+type exercise struct {
+	dir string
+	run func()
+}
+
+var exercises = map[string]exercise{
+	"hello_world":          {dir: "01_hello_world"},
+	"variables":            {dir: "02_variables"},
+	"constants":            {dir: "03_constants"},
+	"for":                  {dir: "04_for"},
+	"if_else":              {dir: "05_if_else"},
+	"switch":               {dir: "06_switch"},
+	"arrays":               {dir: "07_arrays"},
+	"generics":             {run: generics.Run},
+	"errors":               {run: errorhandling.Run},
+	"benchmarks":           {run: benchmarking.Run},
+	"dependency_injection": {run: dependencyinjection.Run},
+	"json":                 {run: jsonexercise.Run},
+	"http":                 {run: httpexercise.Run},
+	"maps":                 {dir: "14_maps"},
+	"structs":              {dir: "15_structs"},
+	"methods":              {dir: "16_methods"},
+	"interfaces":           {dir: "17_interfaces"},
+	"time":                 {dir: "18_time"},
+	"booking-app":          {dir: "booking-app"},
+}
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] == "list" {
+		list()
+		return
+	}
+
+	name := os.Args[1]
+	e, ok := exercises[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown exercise %q\n\n", name)
+		list()
+		os.Exit(2)
+	}
+
+	if e.run != nil {
+		e.run()
+		return
+	}
+
+	dir, err := exerciseDir(e.dir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	cmd := exec.Command("go", "run", ".")
+	cmd.Dir = dir
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Running %s failed: %v\n", name, err)
+		os.Exit(1)
+	}
+}
+
+// exerciseDir finds an exercise folder relative to the repository root,
+// which is two levels above this file, so a built binary works from any
+// directory. When the source path isn't available (e.g. -trimpath) it falls
+// back to the current directory.
+func exerciseDir(dir string) (string, error) {
+	if _, file, _, ok := runtime.Caller(0); ok {
+		path := filepath.Join(filepath.Dir(file), "..", "..", dir)
+		if isDir(path) {
+			return path, nil
+		}
+	}
+	if isDir(dir) {
+		return dir, nil
+	}
+	return "", fmt.Errorf("exercise folder %s not found, run the command from the repository root", dir)
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+func list() {
+	names := make([]string, 0, len(exercises))
+	for name := range exercises {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Println("Usage: go run ./cmd/exercises <name>")
+	fmt.Println("Available exercises:")
+	for _, name := range names {
+		fmt.Println("  ", name)
+	}
+}
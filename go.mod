@@ -0,0 +1,21 @@
+module exercise
+
+go 1.23.4
+
+require (
+	benchmarking v0.0.0
+	dependencyinjection v0.0.0
+	errorhandling v0.0.0
+	generics v0.0.0
+	httpexercise v0.0.0
+	jsonexercise v0.0.0
+)
+
+replace (
+	generics => ./08_generics
+	errorhandling => ./09_errors
+	benchmarking => ./10_benchmarks
+	dependencyinjection => ./11_dependency_injection
+	jsonexercise => ./12_json
+	httpexercise => ./13_http
+)